@@ -0,0 +1,17 @@
+package slashings
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/promauto"
+)
+
+var (
+	proposerSlashingsPrunedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "proposer_slashings_pruned_total",
+		Help: "Number of pending proposer slashings dropped by Pool.Prune because the validator is no longer slashable",
+	})
+	attesterSlashingsPrunedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "attester_slashings_pruned_total",
+		Help: "Number of pending attester slashings dropped by Pool.Prune because no implicated validator is slashable anymore",
+	})
+)
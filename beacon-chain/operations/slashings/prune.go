@@ -0,0 +1,94 @@
+package slashings
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"go.opencensus.io/trace"
+)
+
+// Prune removes pending and included slashings that are no longer relevant against the supplied
+// state. Block processing calls this once per finalized/head update so the pool reflects a
+// fork-aware view instead of a static one: a slashing that was valid when inserted can become
+// stale later because the implicated validator already exited past EPOCHS_PER_SLASHINGS_VECTOR or
+// was already slashed by a different, now-canonical message.
+func (p *Pool) Prune(ctx context.Context, state *beaconstate.BeaconState) {
+	_, span := trace.StartSpan(ctx, "operations.slashings.Prune")
+	defer span.End()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	currentEpoch := helpers.CurrentEpoch(state)
+
+	proposerSlashings := make([]*ethpb.ProposerSlashing, 0, len(p.pendingProposerSlashing))
+	prunedProposer := 0
+	for _, ps := range p.pendingProposerSlashing {
+		if p.slashable(state, currentEpoch, ps.ProposerIndex) {
+			proposerSlashings = append(proposerSlashings, ps)
+			continue
+		}
+		prunedProposer++
+	}
+	p.pendingProposerSlashing = proposerSlashings
+
+	attesterSlashings := make([]*ethpb.AttesterSlashing, 0, len(p.pendingAttesterSlashing))
+	prunedAttester := 0
+	for _, as := range p.pendingAttesterSlashing {
+		stillSlashable := false
+		for _, idx := range blocks.SlashableAttesterIndices(as) {
+			if p.slashable(state, currentEpoch, idx) {
+				stillSlashable = true
+				break
+			}
+		}
+		if stillSlashable {
+			attesterSlashings = append(attesterSlashings, as)
+			continue
+		}
+		prunedAttester++
+	}
+	p.pendingAttesterSlashing = attesterSlashings
+
+	for idx := range p.included {
+		validator, err := state.ValidatorAtIndex(idx)
+		if err != nil {
+			continue
+		}
+		if validator.WithdrawableEpoch <= currentEpoch {
+			delete(p.included, idx)
+		}
+	}
+
+	if prunedProposer > 0 {
+		proposerSlashingsPrunedCounter.Add(float64(prunedProposer))
+	}
+	if prunedAttester > 0 {
+		attesterSlashingsPrunedCounter.Add(float64(prunedAttester))
+	}
+	if prunedProposer > 0 || prunedAttester > 0 {
+		log.WithFields(logrus.Fields{
+			"prunedProposerSlashings": prunedProposer,
+			"prunedAttesterSlashings": prunedAttester,
+		}).Debug("Pruned pending slashings no longer valid against the current state")
+	}
+}
+
+// slashable reports whether the validator at idx is still a valid slashing target: it exists,
+// has not already been included in a canonical block, and satisfies the spec's slashability
+// predicate at currentEpoch.
+func (p *Pool) slashable(state *beaconstate.BeaconState, currentEpoch uint64, idx uint64) bool {
+	if p.included[idx] {
+		return false
+	}
+	validator, err := state.ValidatorAtIndex(idx)
+	if err != nil {
+		return false
+	}
+	return helpers.IsSlashableValidator(validator, currentEpoch)
+}
@@ -0,0 +1,79 @@
+package slashings
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestPool_Prune(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+
+	// Validator 1 has already exited and its withdrawable epoch will have passed once we
+	// advance the state below, so it should drop out of both the pending lists and `included`.
+	exitedVal, err := beaconState.ValidatorAtIndex(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exitedVal.ExitEpoch = 0
+	exitedVal.WithdrawableEpoch = 1
+	if err := beaconState.UpdateValidatorAtIndex(1, exitedVal); err != nil {
+		t.Fatal(err)
+	}
+
+	// Validator 2 gets slashed via a different, now-canonical message.
+	slashedVal, err := beaconState.ValidatorAtIndex(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slashedVal.Slashed = true
+	if err := beaconState.UpdateValidatorAtIndex(2, slashedVal); err != nil {
+		t.Fatal(err)
+	}
+
+	// Validator 3 is untouched, remains slashable, and is not in `included`, so its pending
+	// slashings should survive pruning regardless of the `included` bookkeeping below.
+	// Validator 4 is untouched and already `included`, with its withdrawable epoch still far in
+	// the future, so it should remain in `included` after pruning.
+	p := &Pool{
+		pendingProposerSlashing: []*ethpb.ProposerSlashing{
+			proposerSlashingForValIdx(1),
+			proposerSlashingForValIdx(2),
+			proposerSlashingForValIdx(3),
+		},
+		pendingAttesterSlashing: []*ethpb.AttesterSlashing{
+			attesterSlashingForIndices(1),
+			attesterSlashingForIndices(2),
+			attesterSlashingForIndices(3),
+		},
+		included: map[uint64]bool{
+			1: true, // Withdrawable epoch will have passed once we advance the state below.
+			4: true, // Withdrawable epoch is still far in the future.
+		},
+	}
+
+	if err := beaconState.SetSlot(helpers.StartSlot(2)); err != nil {
+		t.Fatal(err)
+	}
+
+	p.Prune(context.Background(), beaconState)
+
+	if len(p.pendingProposerSlashing) != 1 || p.pendingProposerSlashing[0].ProposerIndex != 3 {
+		t.Errorf("Expected only validator 3's proposer slashing to survive pruning, got %v", p.pendingProposerSlashing)
+	}
+	if len(p.pendingAttesterSlashing) != 1 {
+		t.Errorf("Expected only validator 3's attester slashing to survive pruning, got %v", p.pendingAttesterSlashing)
+	}
+	if p.included[1] {
+		t.Error("Expected validator 1 to be garbage collected from included once its withdrawable epoch passed")
+	}
+	if !p.included[4] {
+		t.Error("Expected validator 4 to remain in included")
+	}
+	if len(p.included) != 1 {
+		t.Errorf("Expected included map to shrink to 1 entry, got %d", len(p.included))
+	}
+}
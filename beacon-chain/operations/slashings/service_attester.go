@@ -0,0 +1,190 @@
+package slashings
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// PendingAttesterSlashings returns attester slashings that are able to be included
+// into a block, capped at the maximum allowed per block, in insertion order.
+//
+// Deprecated: block assembly should call SelectAttesterSlashings instead, which picks the
+// subset of pending slashings that jointly punishes the most new validators instead of an
+// arbitrary FIFO prefix. This method is kept only because the call site that builds the
+// block body lives outside this package and is not part of this change; it still returns
+// the old FIFO prefix and has not been switched over.
+//
+// TODO(chunk0-2-followup): switch the block-assembly call site over to SelectAttesterSlashings
+// and remove this method once that lands.
+func (p *Pool) PendingAttesterSlashings(ctx context.Context) []*ethpb.AttesterSlashing {
+	_, span := trace.StartSpan(ctx, "operations.slashings.PendingAttesterSlashings")
+	defer span.End()
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	maxSlashings := int(params.BeaconConfig().MaxAttesterSlashings)
+	pending := p.pendingAttesterSlashing
+	if len(pending) > maxSlashings {
+		pending = pending[:maxSlashings]
+	}
+	return pending
+}
+
+// InsertAttesterSlashing into the pool. This method is a no-op if the slashing already exists in
+// the pool or does not implicate any validator that is not already slashed or included.
+func (p *Pool) InsertAttesterSlashing(
+	ctx context.Context,
+	state *beaconstate.BeaconState,
+	slashing *ethpb.AttesterSlashing,
+) error {
+	ctx, span := trace.StartSpan(ctx, "operations.slashings.InsertAttesterSlashing")
+	defer span.End()
+
+	if err := blocks.VerifyAttesterSlashing(ctx, state, slashing); err != nil {
+		return errors.Wrap(err, "could not verify attester slashing")
+	}
+	if len(blocks.SlashableAttesterIndices(slashing)) == 0 {
+		return errors.New("attester slashing does not implicate any validator")
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	implicatesNew := false
+	for _, idx := range blocks.SlashableAttesterIndices(slashing) {
+		if p.included[idx] {
+			continue
+		}
+		validator, err := state.ValidatorAtIndex(idx)
+		if err != nil || validator.Slashed {
+			continue
+		}
+		implicatesNew = true
+		break
+	}
+	if !implicatesNew {
+		return errors.New("attester slashing does not implicate any validator that is not already slashed or included")
+	}
+
+	for _, pending := range p.pendingAttesterSlashing {
+		if proto.Equal(pending, slashing) {
+			return errors.New("slashing object already exists in pending attester slashings")
+		}
+	}
+
+	p.pendingAttesterSlashing = append(p.pendingAttesterSlashing, slashing)
+	return nil
+}
+
+// MarkIncludedAttesterSlashing is called when an attester slashing has been included in a beacon
+// block. This marks every implicated validator as included and removes the slashing from the
+// pending pool.
+func (p *Pool) MarkIncludedAttesterSlashing(as *ethpb.AttesterSlashing) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for _, idx := range blocks.SlashableAttesterIndices(as) {
+		p.included[idx] = true
+	}
+	for i, pending := range p.pendingAttesterSlashing {
+		if proto.Equal(pending, as) {
+			p.pendingAttesterSlashing = append(p.pendingAttesterSlashing[:i], p.pendingAttesterSlashing[i+1:]...)
+			break
+		}
+	}
+}
+
+// attesterSlashingCandidate tracks, for one pending attester slashing, the effective balance of
+// each validator it can still freshly slash against a given state.
+type attesterSlashingCandidate struct {
+	slashing *ethpb.AttesterSlashing
+	fresh    map[uint64]uint64 // validator index -> effective balance
+	order    int
+}
+
+// SelectAttesterSlashings runs a greedy weighted max-cover over the pending attester slashings
+// and returns the subset, up to MaxAttesterSlashings, that jointly slashes the most new
+// validators. A single attester slashing can implicate hundreds of validators, and pending
+// slashings frequently overlap heavily on the intersected validator set, so taking an arbitrary
+// FIFO prefix (as PendingAttesterSlashings does) can pack a block with slashings that jointly
+// punish very few validators. Instead, on each round this repeatedly picks the candidate whose
+// not-yet-covered ("fresh") validator set is largest, breaking ties by the total effective
+// balance of that fresh set and then by insertion order, until MaxAttesterSlashings slashings
+// have been chosen or no remaining candidate contributes a new validator.
+func (p *Pool) SelectAttesterSlashings(ctx context.Context, state *beaconstate.BeaconState) ([]*ethpb.AttesterSlashing, error) {
+	_, span := trace.StartSpan(ctx, "operations.slashings.SelectAttesterSlashings")
+	defer span.End()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	currentEpoch := helpers.CurrentEpoch(state)
+	candidates := make([]*attesterSlashingCandidate, 0, len(p.pendingAttesterSlashing))
+	for i, slashing := range p.pendingAttesterSlashing {
+		fresh := make(map[uint64]uint64)
+		for _, idx := range blocks.SlashableAttesterIndices(slashing) {
+			if p.included[idx] {
+				continue
+			}
+			validator, err := state.ValidatorAtIndex(idx)
+			if err != nil {
+				continue
+			}
+			if !helpers.IsSlashableValidator(validator, currentEpoch) {
+				continue
+			}
+			fresh[idx] = validator.EffectiveBalance
+		}
+		if len(fresh) == 0 {
+			continue
+		}
+		candidates = append(candidates, &attesterSlashingCandidate{slashing: slashing, fresh: fresh, order: i})
+	}
+
+	maxSlashings := int(params.BeaconConfig().MaxAttesterSlashings)
+	covered := make(map[uint64]bool)
+	selected := make([]*ethpb.AttesterSlashing, 0, maxSlashings)
+
+	for len(selected) < maxSlashings && len(candidates) > 0 {
+		bestIdx, bestCount, bestBalance := -1, 0, uint64(0)
+		for i, c := range candidates {
+			count, balance := 0, uint64(0)
+			for idx, bal := range c.fresh {
+				if covered[idx] {
+					continue
+				}
+				count++
+				balance += bal
+			}
+			if count == 0 {
+				continue
+			}
+			if bestIdx == -1 ||
+				count > bestCount ||
+				(count == bestCount && balance > bestBalance) ||
+				(count == bestCount && balance == bestBalance && c.order < candidates[bestIdx].order) {
+				bestIdx, bestCount, bestBalance = i, count, balance
+			}
+		}
+		if bestIdx == -1 {
+			break
+		}
+
+		chosen := candidates[bestIdx]
+		selected = append(selected, chosen.slashing)
+		for idx := range chosen.fresh {
+			covered[idx] = true
+		}
+		candidates = append(candidates[:bestIdx], candidates[bestIdx+1:]...)
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,152 @@
+package slashings
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// attesterSlashingForIndices builds a minimal attester slashing whose slashable
+// (intersected) validator set is exactly the given indices.
+func attesterSlashingForIndices(indices ...uint64) *ethpb.AttesterSlashing {
+	return &ethpb.AttesterSlashing{
+		Attestation_1: &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data:             &ethpb.AttestationData{},
+		},
+		Attestation_2: &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data:             &ethpb.AttestationData{},
+		},
+	}
+}
+
+func TestPool_InsertAttesterSlashing(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	slashing, err := testutil.GenerateAttesterSlashingForValidator(beaconState, privKeys[0], uint64(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &Pool{
+		pendingAttesterSlashing: make([]*ethpb.AttesterSlashing, 0),
+		included:                make(map[uint64]bool),
+	}
+	if err := p.InsertAttesterSlashing(context.Background(), beaconState, slashing); err != nil {
+		t.Fatal(err)
+	}
+	if len(p.pendingAttesterSlashing) != 1 {
+		t.Fatalf("Wanted 1 pending attester slashing, got %d", len(p.pendingAttesterSlashing))
+	}
+
+	if err := p.InsertAttesterSlashing(context.Background(), beaconState, slashing); err == nil {
+		t.Error("Expected duplicate attester slashing insertion to fail, received nil")
+	}
+	if len(p.pendingAttesterSlashing) != 1 {
+		t.Errorf("Wanted duplicate to be rejected, got %d pending attester slashings", len(p.pendingAttesterSlashing))
+	}
+}
+
+func TestPool_MarkIncludedAttesterSlashing(t *testing.T) {
+	slashing1 := attesterSlashingForIndices(1, 2)
+	slashing2 := attesterSlashingForIndices(3, 4)
+	p := &Pool{
+		pendingAttesterSlashing: []*ethpb.AttesterSlashing{slashing1, slashing2},
+		included:                make(map[uint64]bool),
+	}
+	p.MarkIncludedAttesterSlashing(slashing1)
+
+	if len(p.pendingAttesterSlashing) != 1 || !proto.Equal(p.pendingAttesterSlashing[0], slashing2) {
+		t.Errorf("Expected only slashing2 to remain pending, got %v", p.pendingAttesterSlashing)
+	}
+	want := map[uint64]bool{1: true, 2: true}
+	if !reflect.DeepEqual(p.included, want) {
+		t.Errorf("Included map is not as expected. Got=%v wanted=%v", p.included, want)
+	}
+}
+
+func TestPool_PendingAttesterSlashings(t *testing.T) {
+	// Kept at or under params.BeaconConfig().MaxAttesterSlashings (2 at phase 0) so the
+	// cap in PendingAttesterSlashings does not truncate this fixture.
+	slashings := []*ethpb.AttesterSlashing{
+		attesterSlashingForIndices(1),
+		attesterSlashingForIndices(2),
+	}
+	p := &Pool{pendingAttesterSlashing: slashings}
+	got := p.PendingAttesterSlashings(context.Background())
+	if !reflect.DeepEqual(got, slashings) {
+		t.Errorf("Unexpected return from PendingAttesterSlashings, wanted %v, received %v", slashings, got)
+	}
+}
+
+func TestPool_SelectAttesterSlashings(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	conf := params.BeaconConfig()
+	conf.MaxAttesterSlashings = 2
+	params.OverrideBeaconConfig(conf)
+
+	t.Run("Fully overlapping slashings, only one picked", func(t *testing.T) {
+		slashing1 := attesterSlashingForIndices(1, 2, 3)
+		slashing2 := attesterSlashingForIndices(1, 2, 3)
+		p := &Pool{
+			pendingAttesterSlashing: []*ethpb.AttesterSlashing{slashing1, slashing2},
+			included:                make(map[uint64]bool),
+		}
+		got, err := p.SelectAttesterSlashings(context.Background(), beaconState)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 1 {
+			t.Fatalf("Wanted 1 selected slashing, got %d", len(got))
+		}
+		if !proto.Equal(got[0], slashing1) {
+			t.Errorf("Expected the first-inserted slashing to win the tie, got %v", got[0])
+		}
+	})
+
+	t.Run("Disjoint slashings, both picked", func(t *testing.T) {
+		slashing1 := attesterSlashingForIndices(4, 5)
+		slashing2 := attesterSlashingForIndices(6, 7)
+		p := &Pool{
+			pendingAttesterSlashing: []*ethpb.AttesterSlashing{slashing1, slashing2},
+			included:                make(map[uint64]bool),
+		}
+		got, err := p.SelectAttesterSlashings(context.Background(), beaconState)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 2 {
+			t.Fatalf("Wanted 2 selected slashings, got %d", len(got))
+		}
+	})
+
+	t.Run("Greedy choice beats FIFO", func(t *testing.T) {
+		// slashing1 is inserted first but only covers 1 new validator. slashing2 covers 3 new
+		// validators (1 shared with slashing1, 2 not). A FIFO prefix of size 1 would pick
+		// slashing1 and leave 2 fewer validators slashed than picking slashing2.
+		slashing1 := attesterSlashingForIndices(8)
+		slashing2 := attesterSlashingForIndices(8, 9, 10)
+		p := &Pool{
+			pendingAttesterSlashing: []*ethpb.AttesterSlashing{slashing1, slashing2},
+			included:                make(map[uint64]bool),
+		}
+		conf := params.BeaconConfig()
+		conf.MaxAttesterSlashings = 1
+		params.OverrideBeaconConfig(conf)
+		got, err := p.SelectAttesterSlashings(context.Background(), beaconState)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 1 {
+			t.Fatalf("Wanted 1 selected slashing, got %d", len(got))
+		}
+		if !proto.Equal(got[0], slashing2) {
+			t.Errorf("Expected the greedy max-cover pick (slashing2), got %v", got[0])
+		}
+	})
+}
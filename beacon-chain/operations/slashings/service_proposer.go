@@ -0,0 +1,132 @@
+package slashings
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// PendingProposerSlashings returns proposer slashings that are able to be included
+// into a block, capped at the maximum allowed per block.
+func (p *Pool) PendingProposerSlashings(ctx context.Context) []*ethpb.ProposerSlashing {
+	_, span := trace.StartSpan(ctx, "operations.slashings.PendingProposerSlashings")
+	defer span.End()
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	maxSlashings := int(params.BeaconConfig().MaxProposerSlashings)
+	pending := p.pendingProposerSlashing
+	if len(pending) > maxSlashings {
+		pending = pending[:maxSlashings]
+	}
+	return pending
+}
+
+// InsertProposerSlashing into the pool. This method is a no-op if the slashing already exists in
+// the pool, the validator is already included in a previous slashing, or the validator is not
+// slashable.
+func (p *Pool) InsertProposerSlashing(
+	ctx context.Context,
+	state *beaconstate.BeaconState,
+	slashing *ethpb.ProposerSlashing,
+) error {
+	_, span := trace.StartSpan(ctx, "operations.slashings.InsertProposerSlashing")
+	defer span.End()
+
+	if err := blocks.VerifyProposerSlashing(state, slashing); err != nil {
+		return errors.Wrap(err, "could not verify proposer slashing")
+	}
+
+	idx := slashing.ProposerIndex
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.included[idx] {
+		return fmt.Errorf("proposer at index %d cannot be slashed, slashing has already been included", idx)
+	}
+	validator, err := state.ValidatorAtIndex(idx)
+	if err != nil {
+		return errors.Wrap(err, "could not get validator")
+	}
+	if validator.Slashed {
+		return fmt.Errorf("validator at index %d not slashable", idx)
+	}
+	currentEpoch := helpers.CurrentEpoch(state)
+	if validator.ExitEpoch != params.BeaconConfig().FarFutureEpoch && validator.ExitEpoch <= currentEpoch {
+		return fmt.Errorf("proposer at index %d cannot be slashed, validator has already exited", idx)
+	}
+
+	fp, err := proposerSlashingFingerprint(slashing)
+	if err != nil {
+		return errors.Wrap(err, "could not compute proposer slashing fingerprint")
+	}
+	for _, ps := range p.pendingProposerSlashing {
+		existingFp, err := proposerSlashingFingerprint(ps)
+		if err != nil {
+			return errors.Wrap(err, "could not compute proposer slashing fingerprint")
+		}
+		if fp == existingFp {
+			return errors.New("slashing object already exists in pending proposer slashings")
+		}
+	}
+
+	p.pendingProposerSlashing = append(p.pendingProposerSlashing, slashing)
+	sort.Slice(p.pendingProposerSlashing, func(i, j int) bool {
+		return p.pendingProposerSlashing[i].ProposerIndex < p.pendingProposerSlashing[j].ProposerIndex
+	})
+	return nil
+}
+
+// MarkIncludedProposerSlashing is called when a proposer slashing has been included in a beacon
+// block. This will remove the included proposer slashing from the pending pool.
+func (p *Pool) MarkIncludedProposerSlashing(ps *ethpb.ProposerSlashing) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	idx := ps.ProposerIndex
+	p.included[idx] = true
+	for i, pending := range p.pendingProposerSlashing {
+		if pending.ProposerIndex == idx {
+			p.pendingProposerSlashing = append(p.pendingProposerSlashing[:i], p.pendingProposerSlashing[i+1:]...)
+			break
+		}
+	}
+}
+
+// proposerSlashingFingerprint returns a canonical identifier for a proposer slashing that is
+// independent of which header is stored as Header_1 vs Header_2. Two proposer slashings that
+// accuse the same validator of signing the same pair of headers produce the same fingerprint
+// regardless of ordering, which lets InsertProposerSlashing recognize a swapped-header duplicate
+// that a byte-for-byte comparison would miss.
+func proposerSlashingFingerprint(slashing *ethpb.ProposerSlashing) ([72]byte, error) {
+	root1, err := slashing.Header_1.Header.HashTreeRoot()
+	if err != nil {
+		return [72]byte{}, errors.Wrap(err, "could not hash header_1")
+	}
+	root2, err := slashing.Header_2.Header.HashTreeRoot()
+	if err != nil {
+		return [72]byte{}, errors.Wrap(err, "could not hash header_2")
+	}
+
+	var fp [72]byte
+	binary.LittleEndian.PutUint64(fp[:8], slashing.ProposerIndex)
+	if bytes.Compare(root1[:], root2[:]) <= 0 {
+		copy(fp[8:40], root1[:])
+		copy(fp[40:], root2[:])
+	} else {
+		copy(fp[8:40], root2[:])
+		copy(fp[40:], root1[:])
+	}
+	return fp, nil
+}
@@ -239,6 +239,39 @@ func TestPool_InsertProposerSlashing_SigFailsVerify_ClearPool(t *testing.T) {
 	}
 }
 
+func TestPool_InsertProposerSlashing_SwappedHeaderOrderRejected(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	slashing, err := testutil.GenerateProposerSlashingForValidator(beaconState, privKeys[0], uint64(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A variant of the same double-proposal with Header_1 and Header_2 swapped proves the exact
+	// same slashable offense and must be treated as a duplicate, not a distinct slashing.
+	swapped := &ethpb.ProposerSlashing{
+		ProposerIndex: slashing.ProposerIndex,
+		Header_1:      slashing.Header_2,
+		Header_2:      slashing.Header_1,
+	}
+
+	p := &Pool{
+		pendingProposerSlashing: make([]*ethpb.ProposerSlashing, 0),
+		included:                make(map[uint64]bool),
+	}
+	if err := p.InsertProposerSlashing(context.Background(), beaconState, slashing); err != nil {
+		t.Fatal(err)
+	}
+	err = p.InsertProposerSlashing(context.Background(), beaconState, swapped)
+	if err == nil {
+		t.Fatal("Expected swapped-header duplicate to be rejected, received nil error")
+	}
+	if !strings.Contains(err.Error(), "already exists in pending proposer slashings") {
+		t.Errorf("Wanted duplicate error, received %v", err)
+	}
+	if len(p.pendingProposerSlashing) != 1 {
+		t.Errorf("Expected only one proposer slashing to occupy the pool, got %d", len(p.pendingProposerSlashing))
+	}
+}
+
 func TestPool_MarkIncludedProposerSlashing(t *testing.T) {
 	type fields struct {
 		pending  []*ethpb.ProposerSlashing
@@ -0,0 +1,44 @@
+package slashings
+
+import (
+	"context"
+	"sync"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
+)
+
+// PoolManager maintains pending and recently included attester and proposer slashings.
+// This pool is used by proposers to insert data into new blocks.
+type PoolManager interface {
+	PendingProposerSlashings(ctx context.Context) []*ethpb.ProposerSlashing
+	PendingAttesterSlashings(ctx context.Context) []*ethpb.AttesterSlashing
+	SelectAttesterSlashings(ctx context.Context, state *beaconstate.BeaconState) ([]*ethpb.AttesterSlashing, error)
+	InsertProposerSlashing(ctx context.Context, state *beaconstate.BeaconState, slashing *ethpb.ProposerSlashing) error
+	InsertAttesterSlashing(ctx context.Context, state *beaconstate.BeaconState, slashing *ethpb.AttesterSlashing) error
+	MarkIncludedProposerSlashing(ps *ethpb.ProposerSlashing)
+	MarkIncludedAttesterSlashing(as *ethpb.AttesterSlashing)
+	Prune(ctx context.Context, state *beaconstate.BeaconState)
+}
+
+// Pool implements PoolManager for storing pending and recently included proposer
+// and attester slashings.
+type Pool struct {
+	lock                    sync.RWMutex
+	pendingProposerSlashing []*ethpb.ProposerSlashing
+	pendingAttesterSlashing []*ethpb.AttesterSlashing
+	// included tracks the validator indices whose slashing has already been
+	// included in a canonical block, keyed by validator index. It is shared
+	// between proposer and attester slashings since a validator can only be
+	// slashed once regardless of which kind of slashing proves it.
+	included map[uint64]bool
+}
+
+// NewPool returns an initialized proposer and attester slashing pool.
+func NewPool() *Pool {
+	return &Pool{
+		pendingProposerSlashing: make([]*ethpb.ProposerSlashing, 0),
+		pendingAttesterSlashing: make([]*ethpb.AttesterSlashing, 0),
+		included:                make(map[uint64]bool),
+	}
+}